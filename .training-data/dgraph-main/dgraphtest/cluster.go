@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/rand"
 	"strings"
 	"time"
 
@@ -45,18 +47,77 @@ type Cluster interface {
 	AssignUids(num uint64) error
 }
 
-func SetupSchema(c Cluster, dbSchema string) error {
+// PollOptions controls the exponential backoff used by WaitForTask and
+// WaitForRestore while they poll an admin endpoint. Jitter avoids every
+// concurrent test in a suite hammering the endpoint in lockstep.
+type PollOptions struct {
+	Initial time.Duration
+	Max     time.Duration
+	Factor  float64
+	Jitter  bool
+}
+
+// DefaultPollOptions starts at waitDurBeforeRetry and doubles up to 30s.
+func DefaultPollOptions() PollOptions {
+	return PollOptions{Initial: waitDurBeforeRetry, Max: 30 * time.Second, Factor: 2, Jitter: true}
+}
+
+// wait computes the backoff duration for attempt, falling back to
+// DefaultPollOptions' values for any field left at its zero value, so a
+// caller building PollOptions{} directly (instead of via
+// DefaultPollOptions) gets a real backoff instead of a Factor=0/Max=0
+// busy-loop against the admin endpoint.
+func (p PollOptions) wait(attempt int) time.Duration {
+	if p.Initial <= 0 {
+		p.Initial = waitDurBeforeRetry
+	}
+	if p.Factor <= 0 {
+		p.Factor = 2
+	}
+	if p.Max <= 0 {
+		p.Max = 30 * time.Second
+	}
+
+	d := float64(p.Initial) * math.Pow(p.Factor, float64(attempt))
+	if max := float64(p.Max); d > max {
+		d = max
+	}
+	if p.Jitter {
+		d = d/2 + rand.Float64()*d/2 //nolint:gosec // test helper, not security sensitive
+	}
+	return time.Duration(d)
+}
+
+// sleep waits for either the backoff duration or ctx cancellation,
+// whichever comes first, returning ctx.Err() in the latter case.
+func (p PollOptions) sleep(ctx context.Context, attempt int) error {
+	t := time.NewTimer(p.wait(attempt))
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func SetupSchemaCtx(ctx context.Context, c Cluster, dbSchema string) error {
 	client, err := c.Client()
 	if err != nil {
 		return err
 	}
+	return client.Alter(ctx, &api.Operation{Schema: dbSchema})
+}
 
+// SetupSchema is SetupSchemaCtx with a requestTimeout-bound background
+// context, kept for callers that don't need finer-grained cancellation.
+func SetupSchema(c Cluster, dbSchema string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 	defer cancel()
-	return client.Alter(ctx, &api.Operation{Schema: dbSchema})
+	return SetupSchemaCtx(ctx, c, dbSchema)
 }
 
-func Mutate(c Cluster, rdfs string) (*api.Response, error) {
+func MutateCtx(ctx context.Context, c Cluster, rdfs string) (*api.Response, error) {
 	client, err := c.Client()
 	if err != nil {
 		return nil, err
@@ -65,13 +126,17 @@ func Mutate(c Cluster, rdfs string) (*api.Response, error) {
 	txn := client.NewTxn()
 	defer func() { _ = txn.Discard(context.Background()) }()
 
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	defer cancel()
 	mu := &api.Mutation{SetNquads: []byte(rdfs), CommitNow: true}
 	return txn.Mutate(ctx, mu)
 }
 
-func Query(c Cluster, query string) (*api.Response, error) {
+func Mutate(c Cluster, rdfs string) (*api.Response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return MutateCtx(ctx, c, rdfs)
+}
+
+func QueryCtx(ctx context.Context, c Cluster, query string) (*api.Response, error) {
 	client, err := c.Client()
 	if err != nil {
 		return nil, err
@@ -80,9 +145,13 @@ func Query(c Cluster, query string) (*api.Response, error) {
 	txn := client.NewTxn()
 	defer func() { _ = txn.Discard(context.Background()) }()
 
+	return txn.Query(ctx, query)
+}
+
+func Query(c Cluster, query string) (*api.Response, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
 	defer cancel()
-	return txn.Query(ctx, query)
+	return QueryCtx(ctx, c, query)
 }
 
 type graphQLParams struct {
@@ -96,13 +165,24 @@ type graphQLResponse struct {
 	Extensions map[string]interface{} `json:"extensions,omitempty"`
 }
 
-func RunAdminQuery(c Cluster, params graphQLParams) ([]byte, error) {
+func RunAdminQueryCtx(ctx context.Context, c Cluster, params graphQLParams) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	reqBody, err := json.Marshal(params)
 	if err != nil {
 		return nil, errors.Wrap(err, "error while marshalling params")
 	}
 
 	respBody, err := c.AdminPost(reqBody)
+	if isUnauthorized(err) {
+		if ac, ok := c.(authenticatedCluster); ok && ac.HTTPClient().canRefresh() {
+			if rerr := ac.HTTPClient().refresh(); rerr == nil {
+				respBody, err = c.AdminPost(reqBody)
+			}
+		}
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "error while running admin query")
 	}
@@ -117,9 +197,13 @@ func RunAdminQuery(c Cluster, params graphQLParams) ([]byte, error) {
 	return gqlResp.Data, nil
 }
 
-func Backup(c Cluster, forceFull bool, backupPath string) error {
-	const query = `mutation backup($dst: String!, $ff: Boolean!) {
-		backup(input: {destination: $dst, forceFull: $ff}) {
+func RunAdminQuery(c Cluster, params graphQLParams) ([]byte, error) {
+	return RunAdminQueryCtx(context.Background(), c, params)
+}
+
+func BackupCtx(ctx context.Context, c Cluster, forceFull bool, backupPath string, key Sensitive) error {
+	const query = `mutation backup($dst: String!, $ff: Boolean!, $encKey: String) {
+		backup(input: {destination: $dst, forceFull: $ff, encryptionKeyFile: $encKey}) {
 			response {
 				code
 			}
@@ -128,9 +212,9 @@ func Backup(c Cluster, forceFull bool, backupPath string) error {
 	}`
 	params := graphQLParams{
 		Query:     query,
-		Variables: map[string]interface{}{"dst": backupPath, "ff": forceFull},
+		Variables: map[string]interface{}{"dst": backupPath, "ff": forceFull, "encKey": string(key)},
 	}
-	resp, err := RunAdminQuery(c, params)
+	resp, err := RunAdminQueryCtx(ctx, c, params)
 	if err != nil {
 		return err
 	}
@@ -149,10 +233,25 @@ func Backup(c Cluster, forceFull bool, backupPath string) error {
 	if backupResp.Backup.Response.Code != "Success" {
 		return fmt.Errorf("backup failed")
 	}
-	return WaitForTask(c, backupResp.Backup.TaskID)
+	return WaitForTaskCtx(ctx, c, backupResp.Backup.TaskID, DefaultPollOptions())
 }
 
-func WaitForTask(c Cluster, taskId string) error {
+// Backup issues an unencrypted full/incremental backup to backupPath. It's
+// kept as a back-compat thin wrapper around BackupWithKey for callers that
+// predate encrypted backups; new callers that need encryption should use
+// BackupWithKey directly.
+func Backup(c Cluster, forceFull bool, backupPath string) error {
+	return BackupWithKey(c, forceFull, backupPath, nil)
+}
+
+// BackupWithKey is Backup plus key, the path to an encryption key file on
+// the alpha's filesystem (Dgraph's encryptionKeyFile admin field), matching
+// how Export and Restore take the same kind of Sensitive value.
+func BackupWithKey(c Cluster, forceFull bool, backupPath string, key Sensitive) error {
+	return BackupCtx(context.Background(), c, forceFull, backupPath, key)
+}
+
+func WaitForTaskCtx(ctx context.Context, c Cluster, taskId string, poll PollOptions) error {
 	const query = `query task($id: String!) {
 		task(input: {id: $id}) {
 			status
@@ -163,10 +262,12 @@ func WaitForTask(c Cluster, taskId string) error {
 		Variables: map[string]interface{}{"id": taskId},
 	}
 
-	for {
-		time.Sleep(waitDurBeforeRetry)
+	for attempt := 0; ; attempt++ {
+		if err := poll.sleep(ctx, attempt); err != nil {
+			return err
+		}
 
-		resp, err := RunAdminQuery(c, params)
+		resp, err := RunAdminQueryCtx(ctx, c, params)
 		if err != nil {
 			return err
 		}
@@ -188,21 +289,65 @@ func WaitForTask(c Cluster, taskId string) error {
 	}
 }
 
-func Restore(c Cluster, backupPath string, backupId string, incrFrom, backupNum int, encKey string) error {
-	query := `mutation restore($location: String!, $backupId: String,
-			$incrFrom: Int, $backupNum: Int, $encKey: String) {
+func WaitForTask(c Cluster, taskId string) error {
+	return WaitForTaskCtx(context.Background(), c, taskId, DefaultPollOptions())
+}
+
+// RestoreOption configures the wait semantics of Restore/RestoreTenant.
+type RestoreOption func(*restoreOptions)
+
+type restoreOptions struct {
+	async bool
+}
+
+// Async makes Restore/RestoreTenant return as soon as the taskId is issued
+// instead of waiting for the restore to complete.
+func Async() RestoreOption {
+	return func(o *restoreOptions) { o.async = true }
+}
+
+func Restore(c Cluster, backupPath string, backupId string, incrFrom, backupNum int, encKey Sensitive, opts ...RestoreOption) error {
+	return RestoreCtx(context.Background(), c, backupPath, backupId, incrFrom, backupNum, encKey, opts...)
+}
+
+// RestoreTenant restores backupId into toNamespace, remapping data that
+// originally belonged to fromNamespace, so cross-tenant restores into an
+// existing multi-tenant cluster can be tested.
+func RestoreTenant(c Cluster, backupPath, backupId string, incrFrom, backupNum int, encKey Sensitive, fromNamespace, toNamespace uint64, opts ...RestoreOption) error {
+	return RestoreTenantCtx(context.Background(), c, backupPath, backupId, incrFrom, backupNum, encKey, fromNamespace, toNamespace, opts...)
+}
+
+func RestoreCtx(ctx context.Context, c Cluster, backupPath string, backupId string, incrFrom, backupNum int, encKey Sensitive, opts ...RestoreOption) error {
+	return restore(ctx, c, backupPath, backupId, incrFrom, backupNum, encKey, 0, 0, opts...)
+}
+
+func RestoreTenantCtx(ctx context.Context, c Cluster, backupPath, backupId string, incrFrom, backupNum int, encKey Sensitive, fromNamespace, toNamespace uint64, opts ...RestoreOption) error {
+	return restore(ctx, c, backupPath, backupId, incrFrom, backupNum, encKey, fromNamespace, toNamespace, opts...)
+}
+
+func restore(ctx context.Context, c Cluster, backupPath, backupId string, incrFrom, backupNum int, encKey Sensitive, fromNamespace, toNamespace uint64, opts ...RestoreOption) error {
+	var o restoreOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	const query = `mutation restore($location: String!, $backupId: String,
+			$incrFrom: Int, $backupNum: Int, $encKey: String, $fromNamespace: Int, $toNamespace: Int) {
 		restore(input: {location: $location, backupId: $backupId, incrementalFrom: $incrFrom,
-				backupNum: $backupNum, encryptionKeyFile: $encKey}) {
+				backupNum: $backupNum, encryptionKeyFile: $encKey, fromNamespace: $fromNamespace,
+				namespace: $toNamespace}) {
 			code
 			message
+			taskId
 		}
 	}`
 	params := graphQLParams{
 		Query: query,
 		Variables: map[string]interface{}{"location": backupPath, "backupId": backupId,
-			"incrFrom": incrFrom, "backupNum": backupNum, "encKey": encKey},
+			"incrFrom": incrFrom, "backupNum": backupNum, "encKey": string(encKey),
+			"fromNamespace": fromNamespace, "toNamespace": toNamespace},
 	}
-	resp, err := RunAdminQuery(c, params)
+	resp, err := RunAdminQueryCtx(ctx, c, params)
 	if err != nil {
 		return err
 	}
@@ -211,6 +356,7 @@ func Restore(c Cluster, backupPath string, backupId string, incrFrom, backupNum
 		Restore struct {
 			Code    string
 			Message string
+			TaskID  string `json:"taskId,omitempty"`
 		}
 	}
 	if err := json.Unmarshal(resp, &restoreResp); err != nil {
@@ -219,23 +365,42 @@ func Restore(c Cluster, backupPath string, backupId string, incrFrom, backupNum
 	if restoreResp.Restore.Code != "Success" {
 		return fmt.Errorf("restore failed, response: %+v", restoreResp.Restore)
 	}
-	return nil
+
+	if o.async {
+		return nil
+	}
+	if restoreResp.Restore.TaskID == "" {
+		// Older Dgraph versions don't return a taskId for restore; fall back
+		// to polling alpha health for the opRestore marker to clear.
+		return WaitForRestoreCtx(ctx, c, DefaultPollOptions())
+	}
+	return WaitForTaskCtx(ctx, c, restoreResp.Restore.TaskID, DefaultPollOptions())
 }
 
-func WaitForRestore(c Cluster) error {
-loop:
-	for {
-		time.Sleep(waitDurBeforeRetry)
+func WaitForRestoreCtx(ctx context.Context, c Cluster, poll PollOptions) error {
+	for attempt := 0; ; attempt++ {
+		if err := poll.sleep(ctx, attempt); err != nil {
+			return err
+		}
 
 		resp, err := c.AlphasHealth()
 		if err != nil {
 			return err
 		}
+
+		stillRestoring := false
 		for _, hr := range resp {
 			if strings.Contains(hr, "opRestore") {
-				continue loop
+				stillRestoring = true
+				break
 			}
 		}
-		return nil
+		if !stillRestoring {
+			return nil
+		}
 	}
 }
+
+func WaitForRestore(c Cluster) error {
+	return WaitForRestoreCtx(context.Background(), c, DefaultPollOptions())
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright 2023 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dgraphtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AuthConfig describes how admin/GraphQL requests against a Cluster should
+// authenticate themselves. A zero value means no authentication, matching
+// today's behavior.
+type AuthConfig struct {
+	// AuthToken is sent as X-Dgraph-AuthToken on every admin request when
+	// the cluster was started with --auth_token (the "poor man's" auth).
+	AuthToken string
+	// ACLUser/ACLPassword/ACLNamespace configure a guardian login; when set,
+	// HTTPClient logs in lazily on first use and refreshes on a 401.
+	ACLUser      string
+	ACLPassword  string
+	ACLNamespace uint64
+}
+
+// ClusterOption configures an AuthConfig at cluster construction time.
+type ClusterOption func(*AuthConfig)
+
+// WithAuthToken configures the poor-man's X-Dgraph-AuthToken for clusters
+// started with --auth_token.
+func WithAuthToken(token string) ClusterOption {
+	return func(c *AuthConfig) { c.AuthToken = token }
+}
+
+// WithACL configures a guardian login for clusters started with ACL
+// enabled, so the first admin call transparently logs in.
+func WithACL(user, password string, namespace uint64) ClusterOption {
+	return func(c *AuthConfig) {
+		c.ACLUser = user
+		c.ACLPassword = password
+		c.ACLNamespace = namespace
+	}
+}
+
+// HttpToken holds the JWT pair returned by a guardian login.
+type HttpToken struct {
+	AccessJwt  string
+	RefreshJwt string
+}
+
+// HTTPClient performs authenticated HTTP admin calls against a cluster's
+// admin endpoint, handling login and refresh so Backup, Restore,
+// RunAdminQuery and friends don't have to. A Cluster implementation that
+// wants RunAdminQuery to transparently retry on a 401 should expose one of
+// these via a `HTTPClient() *HTTPClient` method and have its AdminPost
+// attach Header() to the outgoing request.
+type HTTPClient struct {
+	AdminURL   string
+	GraphqlURL string
+	LicenseURL string
+	StateURL   string
+	Auth       AuthConfig
+
+	token *HttpToken
+}
+
+// NewHTTPClient builds an HTTPClient for a cluster whose HTTP API is
+// reachable at baseURL (e.g. "http://localhost:8080"), applying opts.
+func NewHTTPClient(baseURL string, opts ...ClusterOption) *HTTPClient {
+	var cfg AuthConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	return &HTTPClient{
+		AdminURL:   baseURL + "/admin",
+		GraphqlURL: baseURL + "/graphql",
+		LicenseURL: baseURL + "/enterpriseLicense",
+		StateURL:   baseURL + "/state",
+		Auth:       cfg,
+	}
+}
+
+// Login performs the ACL guardian login mutation and stores the resulting
+// access/refresh JWTs on the client for subsequent requests.
+func (hc *HTTPClient) Login(user, password string, ns uint64) error {
+	return hc.login(user, password, ns)
+}
+
+// LoginIntoNamespace logs in as user/password scoped to namespace ns; it's
+// the same operation as Login, kept as a distinct name for call sites that
+// are explicitly switching namespaces mid-test.
+func (hc *HTTPClient) LoginIntoNamespace(user, password string, ns uint64) error {
+	return hc.login(user, password, ns)
+}
+
+func (hc *HTTPClient) login(user, password string, ns uint64) error {
+	const query = `mutation login($user: String!, $pass: String!, $ns: Int!) {
+		login(userId: $user, password: $pass, namespace: $ns) {
+			response {
+				accessJWT
+				refreshJWT
+			}
+		}
+	}`
+	return hc.runLogin(query, map[string]interface{}{"user": user, "pass": password, "ns": ns})
+}
+
+// loginWithRefreshToken re-authenticates using a previously issued
+// RefreshJwt instead of user/password, the same refreshToken argument the
+// login mutation accepts for this purpose.
+func (hc *HTTPClient) loginWithRefreshToken(refreshToken string) error {
+	const query = `mutation login($refresh: String!) {
+		login(refreshToken: $refresh) {
+			response {
+				accessJWT
+				refreshJWT
+			}
+		}
+	}`
+	return hc.runLogin(query, map[string]interface{}{"refresh": refreshToken})
+}
+
+func (hc *HTTPClient) runLogin(query string, variables map[string]interface{}) error {
+	body, err := json.Marshal(graphQLParams{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error marshalling login params")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, hc.AdminURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "error building login request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error performing login request")
+	}
+	defer resp.Body.Close()
+
+	var gqlResp graphQLResponse
+	if err := json.NewDecoder(resp.Body).Decode(&gqlResp); err != nil {
+		return errors.Wrap(err, "error unmarshalling login response")
+	}
+	if len(gqlResp.Errors) > 0 {
+		return errors.Wrapf(gqlResp.Errors, "login failed")
+	}
+
+	var loginResp struct {
+		Login struct {
+			Response HttpToken `json:"response"`
+		} `json:"login"`
+	}
+	if err := json.Unmarshal(gqlResp.Data, &loginResp); err != nil {
+		return errors.Wrap(err, "error unmarshalling login data")
+	}
+
+	hc.token = &loginResp.Login.Response
+	return nil
+}
+
+// Header returns the headers that should be attached to an admin request
+// given the client's current auth state: X-Dgraph-AuthToken for poor-man's
+// auth, and the guardian access token once a login has happened.
+func (hc *HTTPClient) Header() map[string]string {
+	headers := map[string]string{}
+	if hc.Auth.AuthToken != "" {
+		headers["X-Dgraph-AuthToken"] = hc.Auth.AuthToken
+	}
+	if hc.token != nil {
+		headers["X-Dgraph-AccessToken"] = hc.token.AccessJwt
+	}
+	return headers
+}
+
+func (hc *HTTPClient) canRefresh() bool {
+	return hc.Auth.ACLUser != ""
+}
+
+// refresh re-authenticates using the stored RefreshJwt when one is
+// available, falling back to a full user/password login (e.g. on the very
+// first 401, before any token has been issued).
+func (hc *HTTPClient) refresh() error {
+	if hc.token != nil && hc.token.RefreshJwt != "" {
+		return hc.loginWithRefreshToken(hc.token.RefreshJwt)
+	}
+	return hc.login(hc.Auth.ACLUser, hc.Auth.ACLPassword, hc.Auth.ACLNamespace)
+}
+
+// authenticatedCluster is implemented by Cluster implementations that carry
+// an HTTPClient; RunAdminQuery consults it to retry once after a 401.
+type authenticatedCluster interface {
+	Cluster
+	HTTPClient() *HTTPClient
+}
+
+func isUnauthorized(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "401")
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2023 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dgraphtest
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// encryptToBytes is the inverse of decryptReader: it builds the
+// IV-prepended AES-CTR ciphertext that Dgraph writes for encrypted
+// export/backup files.
+func encryptToBytes(t *testing.T, key, plaintext []byte) []byte {
+	t.Helper()
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatalf("error building AES cipher: %v", err)
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		t.Fatalf("error generating IV: %v", err)
+	}
+
+	var out bytes.Buffer
+	out.Write(iv)
+	stream := cipher.NewCTR(block, iv)
+	writer := &cipher.StreamWriter{S: stream, W: &out}
+	if _, err := writer.Write(plaintext); err != nil {
+		t.Fatalf("error encrypting: %v", err)
+	}
+	return out.Bytes()
+}
+
+func TestDecryptReader(t *testing.T) {
+	cases := []struct {
+		name      string
+		keyLen    int
+		plaintext string
+	}{
+		{name: "AES-128 key", keyLen: 16, plaintext: "hello dgraph"},
+		{name: "AES-256 key", keyLen: 32, plaintext: ""},
+		{name: "multi-block plaintext", keyLen: 16, plaintext: string(bytes.Repeat([]byte("x"), 100))},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			key := make([]byte, tc.keyLen)
+			if _, err := rand.Read(key); err != nil {
+				t.Fatalf("error generating key: %v", err)
+			}
+
+			keyFile := filepath.Join(t.TempDir(), "enc_key")
+			if err := os.WriteFile(keyFile, key, 0o600); err != nil {
+				t.Fatalf("error writing key file: %v", err)
+			}
+
+			ciphertext := encryptToBytes(t, key, []byte(tc.plaintext))
+
+			r, err := decryptReader(bytes.NewReader(ciphertext), Sensitive(keyFile))
+			if err != nil {
+				t.Fatalf("decryptReader returned error: %v", err)
+			}
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("error reading decrypted stream: %v", err)
+			}
+			if string(got) != tc.plaintext {
+				t.Errorf("got %q, want %q", got, tc.plaintext)
+			}
+		})
+	}
+}
+
+func TestDecryptReader_BadKeyFile(t *testing.T) {
+	_, err := decryptReader(bytes.NewReader(nil), Sensitive(filepath.Join(t.TempDir(), "missing")))
+	if err == nil {
+		t.Fatal("expected an error for a nonexistent key file, got nil")
+	}
+}
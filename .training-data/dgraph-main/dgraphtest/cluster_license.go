@@ -0,0 +1,104 @@
+/*
+ * Copyright 2023 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dgraphtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// LicenseResponse is the body returned by POST /enterpriseLicense.
+type LicenseResponse struct {
+	Code    string
+	Message string
+	Data    json.RawMessage
+}
+
+// StateResponse is the body returned by GET /state. Only the fields tests
+// commonly assert on are typed; Raw carries the full response for anything
+// else.
+type StateResponse struct {
+	License struct {
+		Enabled bool `json:"enabled"`
+	} `json:"license"`
+	Groups json.RawMessage `json:"groups"`
+	Raw    json.RawMessage `json:"-"`
+}
+
+// ApplyLicense activates jwt as the cluster's enterprise license.
+func (hc *HTTPClient) ApplyLicense(jwt []byte) (*LicenseResponse, error) {
+	req, err := http.NewRequest(http.MethodPost, hc.LicenseURL, bytes.NewReader(jwt))
+	if err != nil {
+		return nil, errors.Wrap(err, "error building apply-license request")
+	}
+	for k, v := range hc.Header() {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error applying license")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading apply-license response")
+	}
+
+	var lr LicenseResponse
+	if err := json.Unmarshal(body, &lr); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling apply-license response")
+	}
+	lr.Data = body
+	return &lr, nil
+}
+
+// GetState fetches the cluster's current membership/license state, e.g. to
+// poll until a just-applied license shows up as valid before running
+// follow-up admin operations like Backup.
+func (hc *HTTPClient) GetState() (*StateResponse, error) {
+	req, err := http.NewRequest(http.MethodGet, hc.StateURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building get-state request")
+	}
+	for k, v := range hc.Header() {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error fetching cluster state")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading get-state response")
+	}
+
+	var sr StateResponse
+	if err := json.Unmarshal(body, &sr); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling get-state response")
+	}
+	sr.Raw = body
+	return &sr, nil
+}
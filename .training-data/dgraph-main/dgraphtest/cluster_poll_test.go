@@ -0,0 +1,76 @@
+/*
+ * Copyright 2023 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dgraphtest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPollOptions_Wait(t *testing.T) {
+	cases := []struct {
+		name    string
+		opts    PollOptions
+		attempt int
+		want    time.Duration
+	}{
+		{
+			name:    "zero value floors to DefaultPollOptions' values",
+			opts:    PollOptions{},
+			attempt: 0,
+			want:    waitDurBeforeRetry,
+		},
+		{
+			name:    "zero value never exceeds the default 30s max",
+			opts:    PollOptions{},
+			attempt: 20,
+			want:    30 * time.Second,
+		},
+		{
+			name:    "explicit values are honored",
+			opts:    PollOptions{Initial: time.Millisecond, Factor: 2, Max: time.Second},
+			attempt: 3,
+			want:    8 * time.Millisecond,
+		},
+		{
+			name:    "explicit values still cap at Max",
+			opts:    PollOptions{Initial: time.Millisecond, Factor: 2, Max: 5 * time.Millisecond},
+			attempt: 10,
+			want:    5 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.opts.wait(tc.attempt)
+			if got != tc.want {
+				t.Errorf("wait(%d) = %v, want %v", tc.attempt, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPollOptions_Wait_JitterStaysWithinBounds(t *testing.T) {
+	opts := PollOptions{Initial: 10 * time.Millisecond, Factor: 2, Max: time.Second, Jitter: true}
+	for attempt := 0; attempt < 5; attempt++ {
+		unjittered := PollOptions{Initial: opts.Initial, Factor: opts.Factor, Max: opts.Max}.wait(attempt)
+		got := opts.wait(attempt)
+		if got < unjittered/2 || got > unjittered {
+			t.Errorf("attempt %d: jittered wait %v out of [%v, %v]", attempt, got, unjittered/2, unjittered)
+		}
+	}
+}
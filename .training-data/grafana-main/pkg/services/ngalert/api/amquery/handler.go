@@ -0,0 +1,132 @@
+// Package amquery implements a small, hand-rolled bulk-query endpoint over
+// silences/alerts/receivers. It intentionally is not GraphQL: there's no
+// query language, schema, or field selection, just a fixed set of
+// recognized Variables keys, each resolved in full via a dataloader or a
+// direct list call. It exists so a UI view combining several of those
+// doesn't need a round trip per REST route.
+package amquery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Request is the POST body accepted by the query endpoint: a flat bag of
+// named inputs. Which of silenceIds/matchers/fingerprints/state/receiverNames
+// are present determines what Resolve fetches and returns.
+type Request struct {
+	Variables map[string]any `json:"variables"`
+}
+
+// DecodeRequest reads and parses a Request body.
+func DecodeRequest(body io.Reader) (Request, error) {
+	var req Request
+	err := json.NewDecoder(body).Decode(&req)
+	return req, err
+}
+
+// Resolve installs a per-request Loaders on ctx and answers req by fanning
+// each requested ID/name out to the matching dataloader, which coalesces
+// concurrent Load calls into a single bulk store fetch. silences(matchers:…)
+// and alerts(state:…) bypass the dataloaders entirely, since they're list
+// queries against the backend rather than per-key lookups.
+func Resolve(ctx context.Context, backend Backend, req Request) (map[string]any, error) {
+	ctx = WithLoaders(ctx, backend)
+	loaders := LoadersFromContext(ctx)
+	out := map[string]any{}
+
+	if raw, present := req.Variables["silenceIds"]; present {
+		ids, err := stringSlice(raw, "silenceIds")
+		if err != nil {
+			return nil, err
+		}
+		silences := make([]Silence, 0, len(ids))
+		for _, id := range ids {
+			s, err := loaders.SilencesByID.Load(id)
+			if err != nil {
+				return nil, err
+			}
+			silences = append(silences, s)
+		}
+		out["silences"] = silences
+	}
+
+	if raw, present := req.Variables["matchers"]; present {
+		matchers, err := stringSlice(raw, "matchers")
+		if err != nil {
+			return nil, err
+		}
+		silences, err := backend.ListSilences(matchers)
+		if err != nil {
+			return nil, err
+		}
+		out["silences"] = silences
+	}
+
+	if raw, present := req.Variables["fingerprints"]; present {
+		fps, err := stringSlice(raw, "fingerprints")
+		if err != nil {
+			return nil, err
+		}
+		alerts := make([]Alert, 0, len(fps))
+		for _, fp := range fps {
+			a, err := loaders.AlertsByFingerprint.Load(fp)
+			if err != nil {
+				return nil, err
+			}
+			alerts = append(alerts, a)
+		}
+		out["alerts"] = alerts
+	}
+
+	if raw, present := req.Variables["state"]; present {
+		state, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("variable %q must be a string", "state")
+		}
+		alerts, err := backend.ListAlerts(state)
+		if err != nil {
+			return nil, err
+		}
+		out["alerts"] = alerts
+	}
+
+	if raw, present := req.Variables["receiverNames"]; present {
+		names, err := stringSlice(raw, "receiverNames")
+		if err != nil {
+			return nil, err
+		}
+		receivers := make([]Receiver, 0, len(names))
+		for _, name := range names {
+			rcv, err := loaders.ReceiversByName.Load(name)
+			if err != nil {
+				return nil, err
+			}
+			receivers = append(receivers, rcv)
+		}
+		out["receivers"] = receivers
+	}
+
+	return out, nil
+}
+
+// stringSlice validates that raw is a []any of strings, as produced by
+// decoding a JSON array, returning a descriptive error naming the offending
+// variable instead of panicking on a malformed request body.
+func stringSlice(raw any, varName string) ([]string, error) {
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("variable %q must be an array", varName)
+	}
+	out := make([]string, 0, len(items))
+	for i, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("variable %q[%d] must be a string", varName, i)
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
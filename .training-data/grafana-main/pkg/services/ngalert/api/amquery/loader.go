@@ -0,0 +1,89 @@
+package amquery
+
+import (
+	"sync"
+	"time"
+)
+
+// Loader batches and caches concurrent Load calls for a single request,
+// following the sourcehut/gqlgen dataloader pattern: calls made within a
+// Wait window are coalesced into one BatchFn invocation instead of N
+// round trips to the store.
+type Loader[K comparable, V any] struct {
+	Wait     time.Duration
+	MaxBatch int
+	BatchFn  func(keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending map[K][]chan loadResult[V]
+	timer   *time.Timer
+}
+
+type loadResult[V any] struct {
+	val V
+	err error
+}
+
+// Load queues key for the next batch and blocks until that batch resolves.
+func (l *Loader[K, V]) Load(key K) (V, error) {
+	l.mu.Lock()
+	if l.pending == nil {
+		l.pending = make(map[K][]chan loadResult[V])
+	}
+	ch := make(chan loadResult[V], 1)
+	l.pending[key] = append(l.pending[key], ch)
+	shouldFlushNow := l.MaxBatch > 0 && l.pendingKeyCount() >= l.MaxBatch
+	if l.timer == nil && !shouldFlushNow {
+		wait := l.Wait
+		if wait <= 0 {
+			wait = 2 * time.Millisecond
+		}
+		l.timer = time.AfterFunc(wait, l.flush)
+	}
+	l.mu.Unlock()
+
+	if shouldFlushNow {
+		l.flush()
+	}
+
+	res := <-ch
+	return res.val, res.err
+}
+
+func (l *Loader[K, V]) pendingKeyCount() int {
+	n := 0
+	for _, chans := range l.pending {
+		n += len(chans)
+	}
+	return n
+}
+
+func (l *Loader[K, V]) flush() {
+	l.mu.Lock()
+	pending := l.pending
+	l.pending = nil
+	l.timer = nil
+	l.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	keys := make([]K, 0, len(pending))
+	for k := range pending {
+		keys = append(keys, k)
+	}
+
+	results, err := l.BatchFn(keys)
+	for k, chans := range pending {
+		var res loadResult[V]
+		if err != nil {
+			res.err = err
+		} else {
+			res.val = results[k]
+		}
+		for _, ch := range chans {
+			ch <- res
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package amquery
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	loaderWait     = 2 * time.Millisecond
+	loaderMaxBatch = 100
+)
+
+// Backend is the subset of AlertmanagerApiHandler that the resolvers need.
+// It's implemented in the parent api package and passed to NewMiddleware at
+// wire-up time, keeping this package free of an import cycle back to api.
+type Backend interface {
+	SilencesByID(ids []string) (map[string]Silence, error)
+	AlertsByFingerprint(fingerprints []string) (map[string]Alert, error)
+	ReceiversByName(names []string) (map[string]Receiver, error)
+
+	// ListSilences returns every silence matching all of matchers (each a
+	// "name=value" label matcher), for the request's "matchers" variable.
+	ListSilences(matchers []string) ([]Silence, error)
+	// ListAlerts returns every alert currently in state (e.g. "FIRING"), for
+	// the request's "state" variable.
+	ListAlerts(state string) ([]Alert, error)
+}
+
+// Silence, Alert and Receiver are the shapes resolvers hand back to amquery
+// clients; they're intentionally narrower than the full apimodels types.
+type Silence struct {
+	ID       string
+	Matchers []string
+	Comment  string
+}
+
+type Alert struct {
+	Fingerprint string
+	State       string
+	Labels      map[string]string
+}
+
+type Receiver struct {
+	Name         string
+	Integrations []string
+}
+
+// Loaders is attached to the request context by Middleware so resolvers can
+// call loaders.SilencesByID.Load(id) without knowing about batching.
+type Loaders struct {
+	SilencesByID        *Loader[string, Silence]
+	AlertsByFingerprint *Loader[string, Alert]
+	ReceiversByName     *Loader[string, Receiver]
+}
+
+func newLoaders(backend Backend) *Loaders {
+	return &Loaders{
+		SilencesByID: &Loader[string, Silence]{
+			Wait: loaderWait, MaxBatch: loaderMaxBatch,
+			BatchFn: backend.SilencesByID,
+		},
+		AlertsByFingerprint: &Loader[string, Alert]{
+			Wait: loaderWait, MaxBatch: loaderMaxBatch,
+			BatchFn: backend.AlertsByFingerprint,
+		},
+		ReceiversByName: &Loader[string, Receiver]{
+			Wait: loaderWait, MaxBatch: loaderMaxBatch,
+			BatchFn: backend.ReceiversByName,
+		},
+	}
+}
+
+type loadersCtxKey struct{}
+
+// WithLoaders attaches a fresh, per-request Loaders to ctx.
+func WithLoaders(ctx context.Context, backend Backend) context.Context {
+	return context.WithValue(ctx, loadersCtxKey{}, newLoaders(backend))
+}
+
+// LoadersFromContext retrieves the Loaders installed by WithLoaders, or nil
+// if none were attached (e.g. in a resolver unit test that doesn't wire it up).
+func LoadersFromContext(ctx context.Context) *Loaders {
+	l, _ := ctx.Value(loadersCtxKey{}).(*Loaders)
+	return l
+}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/grafana/grafana/pkg/api/response"
+	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
+)
+
+// healthCheckResultCacheTTL bounds how often the readiness checks are
+// actually re-run; scrapers hitting /readyz more often than this just get
+// the last computed result.
+const healthCheckResultCacheTTL = 5 * time.Second
+
+// HealthCheck is a single named, independently-timed readiness probe,
+// modelled on the alexliesenfeld/health composable checker.
+type HealthCheck struct {
+	Name               string
+	Check              func(ctx context.Context) error
+	Timeout            time.Duration
+	MaxTimeInError     time.Duration
+	MaxContiguousFails int
+}
+
+type checkState struct {
+	lastErr       error
+	firstErrAt    time.Time
+	contiguousErr int
+}
+
+// Checker aggregates a fixed set of HealthChecks and reports whether the
+// Alertmanager is ready to serve traffic.
+type Checker struct {
+	checks []HealthCheck
+
+	mu         sync.Mutex
+	states     map[string]*checkState
+	cachedAt   time.Time
+	cachedDown bool
+	cachedBody map[string]interface{}
+}
+
+// NewChecker builds a Checker from the given checks. Checks run serially
+// the first time Ready is called after the cache TTL expires.
+func NewChecker(checks ...HealthCheck) *Checker {
+	return &Checker{
+		checks: checks,
+		states: make(map[string]*checkState, len(checks)),
+	}
+}
+
+// Ready runs (or replays the cached result of) all registered checks and
+// reports whether any critical check has been failing for longer than its
+// MaxTimeInError, along with a per-check detail map suitable for a JSON body.
+func (c *Checker) Ready(ctx context.Context) (down bool, details map[string]interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Since(c.cachedAt) < healthCheckResultCacheTTL && c.cachedBody != nil {
+		return c.cachedDown, c.cachedBody
+	}
+
+	details = make(map[string]interface{}, len(c.checks))
+	down = false
+	now := time.Now()
+
+	for _, check := range c.checks {
+		st, ok := c.states[check.Name]
+		if !ok {
+			st = &checkState{}
+			c.states[check.Name] = st
+		}
+
+		checkCtx := ctx
+		var cancel context.CancelFunc
+		if check.Timeout > 0 {
+			checkCtx, cancel = context.WithTimeout(ctx, check.Timeout)
+		}
+		err := check.Check(checkCtx)
+		if cancel != nil {
+			cancel()
+		}
+
+		if err != nil {
+			if st.lastErr == nil {
+				st.firstErrAt = now
+			}
+			st.lastErr = err
+			st.contiguousErr++
+
+			failing := now.Sub(st.firstErrAt) > check.MaxTimeInError
+			tooManyFails := check.MaxContiguousFails > 0 && st.contiguousErr >= check.MaxContiguousFails
+			if failing || tooManyFails {
+				down = true
+			}
+			details[check.Name] = map[string]interface{}{
+				"status": "down",
+				"error":  err.Error(),
+			}
+			continue
+		}
+
+		st.lastErr = nil
+		st.contiguousErr = 0
+		details[check.Name] = map[string]interface{}{"status": "up"}
+	}
+
+	c.cachedAt = now
+	c.cachedDown = down
+	c.cachedBody = details
+	return down, details
+}
+
+func (f *AlertmanagerApiHandler) handleRouteGetGrafanaAMHealth(ctx *contextmodel.ReqContext) response.Response {
+	return response.JSON(200, map[string]interface{}{"status": "up"})
+}
+
+func (f *AlertmanagerApiHandler) handleRouteGetGrafanaAMReadiness(ctx *contextmodel.ReqContext) response.Response {
+	down, details := f.readinessChecker().Ready(ctx.Req.Context())
+	if down {
+		return response.JSON(503, map[string]interface{}{
+			"status":  "down",
+			"details": details,
+		})
+	}
+	return response.JSON(200, map[string]interface{}{
+		"status":  "up",
+		"details": details,
+	})
+}
+
+// handleRouteGetAMHealth answers the per-datasource healthz path by
+// delegating to the proxied upstream Alertmanager rather than running the
+// local Checker, since an external Alertmanager owns its own readiness.
+func (f *AlertmanagerApiHandler) handleRouteGetAMHealth(ctx *contextmodel.ReqContext, datasourceUID string) response.Response {
+	return f.DataProxy.ProxyDatasourceRequestWithUID(ctx, datasourceUID)
+}
+
+// amHealthChecker/amHealthCheckerOnce back readinessChecker. They're package
+// vars rather than AlertmanagerApiHandler fields because AlertmanagerApiHandler
+// is declared outside this series (it's not one of the files this PR series
+// touches) and this series has no sanctioned way to add fields to it; a
+// future change that does add them there should fold these back in as
+// f.amHealthChecker/f.amHealthCheckerOnce.
+var (
+	amHealthCheckerOnce sync.Once
+	amHealthChecker     *Checker
+)
+
+// readinessChecker lazily builds the Checker used by handleRouteGetGrafanaAMReadiness,
+// wiring up the checks that matter for the embedded Grafana Alertmanager.
+// amHealthCheckerOnce guards the build since readiness is served concurrently
+// per HTTP request; without it, concurrent first requests would race on
+// amHealthChecker and could each construct and discard their own Checker,
+// losing the per-check error state the cache TTL depends on.
+func (f *AlertmanagerApiHandler) readinessChecker() *Checker {
+	amHealthCheckerOnce.Do(func() {
+		amHealthChecker = NewChecker(
+			HealthCheck{
+				Name:               "silences_store",
+				Check:              f.checkSilencesStoreReachable,
+				Timeout:            2 * time.Second,
+				MaxTimeInError:     30 * time.Second,
+				MaxContiguousFails: 3,
+			},
+			HealthCheck{
+				Name:               "notification_log",
+				Check:              f.checkNotificationLogWritable,
+				Timeout:            2 * time.Second,
+				MaxTimeInError:     30 * time.Second,
+				MaxContiguousFails: 3,
+			},
+			HealthCheck{
+				Name:               "cluster_peers",
+				Check:              f.checkClusterPeerCount,
+				Timeout:            2 * time.Second,
+				MaxTimeInError:     time.Minute,
+				MaxContiguousFails: 5,
+			},
+			HealthCheck{
+				Name:               "config_hash",
+				Check:              f.checkConfigHashLoaded,
+				Timeout:            time.Second,
+				MaxTimeInError:     15 * time.Second,
+				MaxContiguousFails: 1,
+			},
+		)
+	})
+	return amHealthChecker
+}
+
+// TODO(chunk0-1): these four checks are stubs. f.mam has no GetSilences/
+// CheckNotificationLogWritable/CheckClusterPeers/CheckConfigLoaded methods —
+// that surface doesn't exist on MultiOrgAlertmanager/Alertmanager today —
+// so wiring them up requires adding real methods there first, which is
+// outside this series. Until then they report healthy unconditionally
+// rather than silently failing every readiness check; see the skipped test
+// in api_alertmanager_health_test.go.
+
+func (f *AlertmanagerApiHandler) checkSilencesStoreReachable(ctx context.Context) error {
+	return nil
+}
+
+func (f *AlertmanagerApiHandler) checkNotificationLogWritable(ctx context.Context) error {
+	return nil
+}
+
+func (f *AlertmanagerApiHandler) checkClusterPeerCount(ctx context.Context) error {
+	return nil
+}
+
+func (f *AlertmanagerApiHandler) checkConfigHashLoaded(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChecker_Ready(t *testing.T) {
+	cases := []struct {
+		name       string
+		checks     []HealthCheck
+		wantDown   bool
+		wantStatus map[string]string
+	}{
+		{
+			name: "all healthy",
+			checks: []HealthCheck{
+				{Name: "a", Check: func(ctx context.Context) error { return nil }, MaxContiguousFails: 1},
+			},
+			wantDown:   false,
+			wantStatus: map[string]string{"a": "up"},
+		},
+		{
+			name: "single failure under MaxContiguousFails stays up",
+			checks: []HealthCheck{
+				{Name: "a", Check: func(ctx context.Context) error { return errors.New("boom") }, MaxTimeInError: time.Hour, MaxContiguousFails: 2},
+			},
+			wantDown:   false,
+			wantStatus: map[string]string{"a": "down"},
+		},
+		{
+			name: "MaxContiguousFails of 1 goes down immediately",
+			checks: []HealthCheck{
+				{Name: "a", Check: func(ctx context.Context) error { return errors.New("boom") }, MaxTimeInError: time.Hour, MaxContiguousFails: 1},
+			},
+			wantDown:   true,
+			wantStatus: map[string]string{"a": "down"},
+		},
+		{
+			name: "one down check brings the whole Checker down",
+			checks: []HealthCheck{
+				{Name: "a", Check: func(ctx context.Context) error { return nil }, MaxContiguousFails: 1},
+				{Name: "b", Check: func(ctx context.Context) error { return errors.New("boom") }, MaxTimeInError: time.Hour, MaxContiguousFails: 1},
+			},
+			wantDown:   true,
+			wantStatus: map[string]string{"a": "up", "b": "down"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewChecker(tc.checks...)
+			down, details := c.Ready(context.Background())
+			if down != tc.wantDown {
+				t.Errorf("down = %v, want %v", down, tc.wantDown)
+			}
+			for name, wantStatus := range tc.wantStatus {
+				detail, ok := details[name].(map[string]interface{})
+				if !ok {
+					t.Fatalf("no detail for check %q", name)
+				}
+				if detail["status"] != wantStatus {
+					t.Errorf("check %q status = %v, want %v", name, detail["status"], wantStatus)
+				}
+			}
+		})
+	}
+}
+
+// TestCheckXxx_WiredToRealAlertmanager is skipped: checkSilencesStoreReachable,
+// checkNotificationLogWritable, checkClusterPeerCount and checkConfigHashLoaded
+// are currently stubs (see the TODO above them in api_alertmanager_health.go)
+// because the mam methods they'd need don't exist in this series. Un-skip
+// this once they're wired to a real MultiOrgAlertmanager.
+func TestCheckXxx_WiredToRealAlertmanager(t *testing.T) {
+	t.Skip("checkXxx methods are stubs pending real MultiOrgAlertmanager wiring; see TODO(chunk0-1) in api_alertmanager_health.go")
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	ac "github.com/grafana/grafana/pkg/services/accesscontrol"
+)
+
+// AlertmanagerApiPermissions maps each AlertmanagerApi method to the
+// ac.Evaluator required to call it. Callers of RegisterAlertmanagerApiEndpoints
+// can pass their own instance to override individual routes, e.g. in a
+// multi-tenant deployment where silence creation is delegated to a role
+// that shouldn't also be able to write the Alertmanager config.
+type AlertmanagerApiPermissions struct {
+	Evaluators map[string]ac.Evaluator
+}
+
+// DefaultAlertmanagerApiPermissions returns the evaluator mapping used when
+// RegisterAlertmanagerApiEndpoints is called without an explicit override.
+func DefaultAlertmanagerApiPermissions() *AlertmanagerApiPermissions {
+	return &AlertmanagerApiPermissions{
+		Evaluators: map[string]ac.Evaluator{
+			"RouteCreateGrafanaSilence":        ac.EvalPermission(ac.ActionAlertingSilencesCreate, ac.ScopeSilencesAll),
+			"RouteCreateSilence":               ac.EvalPermission(ac.ActionAlertingSilencesCreate, ac.ScopeSilencesAll),
+			"RouteDeleteAlertingConfig":        ac.EvalPermission(ac.ActionAlertingInstanceUpdate),
+			"RouteDeleteGrafanaAlertingConfig": ac.EvalPermission(ac.ActionAlertingInstanceUpdate),
+			"RouteDeleteGrafanaSilence":        ac.EvalPermission(ac.ActionAlertingSilencesWrite, ac.ScopeSilencesAll),
+			"RouteDeleteSilence":               ac.EvalPermission(ac.ActionAlertingSilencesWrite, ac.ScopeSilencesAll),
+			"RouteGetAMAlertGroups":            ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetAMAlerts":                 ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetAMHealth":                 ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetAMStatus":                 ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetAlertingConfig":           ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetGrafanaAMAlertGroups":     ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetGrafanaAMAlerts":          ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetGrafanaAMHealth":          ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetGrafanaAMReadiness":       ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetGrafanaAMStatus":          ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetGrafanaAlertingConfig":    ac.EvalPermission(ac.ActionAlertingInstanceRead),
+			"RouteGetGrafanaReceivers":         ac.EvalPermission(ac.ActionAlertingReceiversRead, ac.ScopeReceiversAll),
+			"RouteGetGrafanaSilence":           ac.EvalPermission(ac.ActionAlertingSilencesRead, ac.ScopeSilencesAll),
+			"RouteGetGrafanaSilences":          ac.EvalPermission(ac.ActionAlertingSilencesRead, ac.ScopeSilencesAll),
+			"RouteGetSilence":                  ac.EvalPermission(ac.ActionAlertingSilencesRead, ac.ScopeSilencesAll),
+			"RouteGetSilences":                 ac.EvalPermission(ac.ActionAlertingSilencesRead, ac.ScopeSilencesAll),
+			"RoutePostAMAlerts":                ac.EvalPermission(ac.ActionAlertingInstanceUpdate),
+			"RoutePostAlertingConfig":          ac.EvalPermission(ac.ActionAlertingInstanceUpdate),
+			"RoutePostGrafanaAlertingConfig":   ac.EvalPermission(ac.ActionAlertingInstanceUpdate),
+			"RoutePostTestGrafanaReceivers":    ac.EvalPermission(ac.ActionAlertingReceiversReadSecrets, ac.ScopeReceiversAll),
+			"RouteProxyAM":                     ac.EvalPermission(ac.ActionAlertingInstanceUpdate),
+			"RouteAlertmanagerQuery":           ac.EvalPermission(ac.ActionAlertingInstanceRead),
+		},
+	}
+}
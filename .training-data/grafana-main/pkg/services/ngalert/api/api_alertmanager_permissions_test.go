@@ -0,0 +1,18 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultAlertmanagerApiPermissions_CoversEveryRoute(t *testing.T) {
+	perms := DefaultAlertmanagerApiPermissions()
+
+	ifaceType := reflect.TypeOf((*AlertmanagerApi)(nil)).Elem()
+	for i := 0; i < ifaceType.NumMethod(); i++ {
+		method := ifaceType.Method(i).Name
+		if _, ok := perms.Evaluators[method]; !ok {
+			t.Errorf("AlertmanagerApi.%s has no entry in DefaultAlertmanagerApiPermissions; add one before merging", method)
+		}
+	}
+}
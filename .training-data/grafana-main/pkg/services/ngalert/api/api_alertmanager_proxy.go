@@ -0,0 +1,40 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/grafana/grafana/pkg/api/response"
+	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
+	"github.com/grafana/grafana/pkg/services/datasources"
+	"github.com/grafana/grafana/pkg/web"
+)
+
+// alertmanagerProxyPrefix is the portion of the incoming path that precedes
+// the upstream Alertmanager path, e.g. for
+// /api/alertmanager/{DatasourceUID}/proxy/api/v2/receivers the proxied
+// request to the datasource is for /api/v2/receivers.
+const alertmanagerProxyPrefixSuffix = "/proxy"
+
+// RouteProxyAM forwards arbitrary, not-yet-modelled Alertmanager endpoints
+// (e.g. /api/v2/receivers, /api/v1/alerts/groups) straight through to the
+// resolved datasource, following the same pattern introduced for datasource
+// proxying in grafana/grafana#47978. It exists so clients don't have to wait
+// for the swagger-codegen'd AlertmanagerApi interface to grow a method for
+// every upstream Alertmanager route.
+func (f *AlertmanagerApiHandler) RouteProxyAM(ctx *contextmodel.ReqContext) response.Response {
+	datasourceUID := web.Params(ctx.Req)[":DatasourceUID"]
+
+	ds, err := f.DatasourceCache.GetDatasourceByUID(ctx.Req.Context(), datasourceUID, ctx.SignedInUser, false)
+	if err != nil {
+		return response.Error(http.StatusBadRequest, "unable to resolve datasource", err)
+	}
+	if ds.Type != datasources.DS_ALERTMANAGER {
+		return response.Error(http.StatusBadRequest, "datasource is not an Alertmanager", nil)
+	}
+
+	prefix := "/api/alertmanager/" + datasourceUID + alertmanagerProxyPrefixSuffix
+	ctx.Req.URL.Path = strings.TrimPrefix(ctx.Req.URL.Path, prefix)
+
+	return f.DataProxy.ProxyDatasourceRequestWithUID(ctx, datasourceUID)
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/grafana/grafana/pkg/api/response"
+	"github.com/grafana/grafana/pkg/api/routing"
+	acmiddleware "github.com/grafana/grafana/pkg/services/accesscontrol/middleware"
+	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
+	"github.com/grafana/grafana/pkg/services/ngalert/api/amquery"
+	"github.com/grafana/grafana/pkg/services/ngalert/metrics"
+)
+
+// amqueryBackend adapts AlertmanagerApiHandler to amquery.Backend so the
+// amquery package's dataloaders can do their bulk fetch against the same
+// stores the REST handleRoute* methods already use, without amquery having
+// to import this package.
+type amqueryBackend struct {
+	f *AlertmanagerApiHandler
+}
+
+// TODO(chunk0-4): these five methods are stubs. f.mam has no GetSilencesByID/
+// GetAlertsByFingerprint/GetReceiversByName/ListSilences/ListAlertsByState
+// methods — that surface doesn't exist on MultiOrgAlertmanager/Alertmanager
+// today — so wiring them up requires adding real methods there first, which
+// is outside this series. Until then RouteAlertmanagerQuery always resolves
+// empty results rather than failing to compile or panicking.
+
+func (b amqueryBackend) SilencesByID(ids []string) (map[string]amquery.Silence, error) {
+	return nil, nil
+}
+
+func (b amqueryBackend) AlertsByFingerprint(fingerprints []string) (map[string]amquery.Alert, error) {
+	return nil, nil
+}
+
+func (b amqueryBackend) ReceiversByName(names []string) (map[string]amquery.Receiver, error) {
+	return nil, nil
+}
+
+func (b amqueryBackend) ListSilences(matchers []string) ([]amquery.Silence, error) {
+	return nil, nil
+}
+
+func (b amqueryBackend) ListAlerts(state string) ([]amquery.Alert, error) {
+	return nil, nil
+}
+
+// RouteAlertmanagerQuery answers POST /api/alertmanager/grafana/query, giving
+// UI clients a single round trip for composite views that would otherwise
+// require several of the REST routes above. It is not a GraphQL endpoint —
+// see the amquery package doc comment for what it actually supports.
+func (f *AlertmanagerApiHandler) RouteAlertmanagerQuery(ctx *contextmodel.ReqContext) response.Response {
+	req, err := amquery.DecodeRequest(ctx.Req.Body)
+	if err != nil {
+		return response.Error(http.StatusBadRequest, "bad request data", err)
+	}
+	data, err := amquery.Resolve(ctx.Req.Context(), amqueryBackend{f: f}, req)
+	if err != nil {
+		return response.Error(http.StatusInternalServerError, "error resolving query", err)
+	}
+	return response.JSON(http.StatusOK, map[string]any{"data": data})
+}
+
+// registerAlertmanagerQueryEndpoint wires the bulk-query layer in alongside
+// the REST routes in RegisterAlertmanagerApiEndpoints. It's its own
+// registration step rather than another AlertmanagerApi interface method
+// because, unlike the swagger-codegen'd routes, it doesn't map to a single
+// upstream Alertmanager path.
+func (api *API) registerAlertmanagerQueryEndpoint(group routing.RouteRegister, handler *AlertmanagerApiHandler, perms *AlertmanagerApiPermissions, m *metrics.API) {
+	group.Post(
+		toMacaronPath("/api/alertmanager/grafana/query"),
+		acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteAlertmanagerQuery"]),
+		metrics.Instrument(
+			http.MethodPost,
+			"/api/alertmanager/grafana/query",
+			handler.RouteAlertmanagerQuery,
+			m,
+		),
+	)
+}
@@ -0,0 +1,13 @@
+package api
+
+import "testing"
+
+// TestAmqueryBackend_WiredToRealAlertmanager is skipped: amqueryBackend's
+// SilencesByID, AlertsByFingerprint, ReceiversByName, ListSilences and
+// ListAlerts are currently stubs (see the TODO above them in
+// api_alertmanager_query.go) because the mam methods they'd need don't
+// exist in this series. Un-skip this once they're wired to a real
+// MultiOrgAlertmanager.
+func TestAmqueryBackend_WiredToRealAlertmanager(t *testing.T) {
+	t.Skip("amqueryBackend methods are stubs pending real MultiOrgAlertmanager wiring; see TODO(chunk0-4) in api_alertmanager_query.go")
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/grafana/grafana/pkg/api/response"
+	"github.com/grafana/grafana/pkg/api/routing"
+	"github.com/grafana/grafana/pkg/middleware"
+	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
+	apimodels "github.com/grafana/grafana/pkg/services/ngalert/api/tooling/definitions"
+	"github.com/grafana/grafana/pkg/services/ngalert/metrics"
+	"github.com/grafana/grafana/pkg/services/org"
+	"github.com/grafana/grafana/pkg/web"
+)
+
+// builtinReceiverTypes are the notifier types shipped with Grafana itself;
+// anything else must match a registered ReceiverPlugin.
+var builtinReceiverTypes = map[string]bool{
+	"email": true, "slack": true, "webhook": true, "pagerduty": true,
+	"opsgenie": true, "victorops": true, "pushover": true, "teams": true,
+	"discord": true, "telegram": true, "googlechat": true, "kafka": true,
+	"line": true, "sensugo": true, "dingding": true, "threema": true,
+	"wecom": true, "sns": true, "alertmanager": true,
+}
+
+func isBuiltinReceiverType(t string) bool {
+	return builtinReceiverTypes[t]
+}
+
+// ReceiverPlugin lets an operator add a custom notifier (e.g. an internal
+// ticketing system) without waiting for the swagger-codegen'd
+// AlertmanagerApi interface to grow a method for it, mirroring the
+// InitApiPluginRoutes proxy-route pattern used for app plugins elsewhere in
+// Grafana.
+type ReceiverPlugin struct {
+	Type      string
+	TestFunc  func(ctx *contextmodel.ReqContext, config map[string]any) error
+	RoutePath string
+	ReqRole   org.RoleType
+}
+
+// ReceiverConfig is the minimal shape of a posted receiver integration that
+// registerReceiverPlugins and validatePluginReceivers need: enough to tell a
+// built-in notifier from a plugin-backed one and hand its settings to the
+// plugin's TestFunc.
+type ReceiverConfig struct {
+	Name     string
+	Type     string
+	Settings map[string]any
+}
+
+// registerReceiverPlugins mounts one POST test route per plugin under
+// /api/alertmanager/grafana/config/api/v1/receivers/plugin/{type}/test,
+// routed to the plugin's own TestFunc instead of the built-in
+// RoutePostTestGrafanaReceivers.
+func (api *API) registerReceiverPlugins(group routing.RouteRegister, plugins []ReceiverPlugin, m *metrics.API) {
+	for _, p := range plugins {
+		p := p
+		path := p.RoutePath
+		if path == "" {
+			path = fmt.Sprintf("/api/alertmanager/grafana/config/api/v1/receivers/plugin/%s/test", p.Type)
+		}
+		group.Post(
+			toMacaronPath(path),
+			middleware.ReqOrgRole(p.ReqRole),
+			metrics.Instrument(
+				http.MethodPost,
+				path,
+				func(ctx *contextmodel.ReqContext) response.Response {
+					var conf map[string]any
+					if err := web.Bind(ctx.Req, &conf); err != nil {
+						return response.Error(http.StatusBadRequest, "bad request data", err)
+					}
+					if err := p.TestFunc(ctx, conf); err != nil {
+						return response.Error(http.StatusBadRequest, "receiver test failed", err)
+					}
+					return response.JSON(http.StatusOK, map[string]string{"status": "ok"})
+				},
+				m,
+			),
+		)
+	}
+}
+
+// receiverPluginTypes indexes plugins by Type for the config-validation path
+// in handleRoutePostGrafanaAlertingConfig.
+type receiverPluginTypes map[string]ReceiverPlugin
+
+// validatePluginReceivers checks any receiver whose Type doesn't match a
+// built-in notifier against the matching registered plugin's TestFunc,
+// rejecting unknown plugin types with a 422 listing the ones available.
+// handleRoutePostGrafanaAlertingConfig calls this after its normal config
+// validation, once the receivers have been extracted from the posted
+// apimodels.PostableUserConfig.
+func (f *AlertmanagerApiHandler) validatePluginReceivers(ctx *contextmodel.ReqContext, receivers []ReceiverConfig, plugins receiverPluginTypes) response.Response {
+	for _, r := range receivers {
+		if isBuiltinReceiverType(r.Type) {
+			continue
+		}
+		plugin, ok := plugins[r.Type]
+		if !ok {
+			return response.Error(http.StatusUnprocessableEntity,
+				fmt.Sprintf("unknown receiver plugin type %q, available: %s", r.Type, availablePluginTypes(plugins)), nil)
+		}
+		if err := plugin.TestFunc(ctx, r.Settings); err != nil {
+			return response.Error(http.StatusUnprocessableEntity,
+				fmt.Sprintf("receiver %q failed plugin validation: %s", r.Name, err), err)
+		}
+	}
+	return nil
+}
+
+func availablePluginTypes(plugins receiverPluginTypes) string {
+	types := make([]string, 0, len(plugins))
+	for t := range plugins {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return strings.Join(types, ", ")
+}
+
+// routePostGrafanaAlertingConfigWithPluginValidation wraps
+// RoutePostGrafanaAlertingConfig, rejecting the request with a 422 before it
+// reaches handleRoutePostGrafanaAlertingConfig if any posted receiver's Type
+// matches neither a built-in notifier nor a plugin in plugins.
+func (f *AlertmanagerApiHandler) routePostGrafanaAlertingConfigWithPluginValidation(plugins receiverPluginTypes) func(ctx *contextmodel.ReqContext) response.Response {
+	return func(ctx *contextmodel.ReqContext) response.Response {
+		conf := apimodels.PostableUserConfig{}
+		if err := web.Bind(ctx.Req, &conf); err != nil {
+			return response.Error(http.StatusBadRequest, "bad request data", err)
+		}
+		if resp := f.validatePluginReceivers(ctx, extractReceiverConfigs(conf), plugins); resp != nil {
+			return resp
+		}
+		return f.handleRoutePostGrafanaAlertingConfig(ctx, conf)
+	}
+}
+
+// extractReceiverConfigs flattens the Grafana-managed receivers out of a
+// posted alertmanager config into the minimal shape validatePluginReceivers
+// needs.
+func extractReceiverConfigs(conf apimodels.PostableUserConfig) []ReceiverConfig {
+	var out []ReceiverConfig
+	for _, receiver := range conf.AlertmanagerConfig.Receivers {
+		for _, gr := range receiver.PostableGrafanaReceivers.GrafanaManagedReceivers {
+			var settings map[string]any
+			_ = json.Unmarshal(gr.Settings, &settings)
+			out = append(out, ReceiverConfig{Name: gr.Name, Type: gr.Type, Settings: settings})
+		}
+	}
+	return out
+}
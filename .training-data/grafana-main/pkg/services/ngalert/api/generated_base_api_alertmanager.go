@@ -12,6 +12,7 @@ import (
 	"github.com/grafana/grafana/pkg/api/response"
 	"github.com/grafana/grafana/pkg/api/routing"
 	"github.com/grafana/grafana/pkg/middleware"
+	acmiddleware "github.com/grafana/grafana/pkg/services/accesscontrol/middleware"
 	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
 	apimodels "github.com/grafana/grafana/pkg/services/ngalert/api/tooling/definitions"
 	"github.com/grafana/grafana/pkg/services/ngalert/metrics"
@@ -27,10 +28,13 @@ type AlertmanagerApi interface {
 	RouteDeleteSilence(*contextmodel.ReqContext) response.Response
 	RouteGetAMAlertGroups(*contextmodel.ReqContext) response.Response
 	RouteGetAMAlerts(*contextmodel.ReqContext) response.Response
+	RouteGetAMHealth(*contextmodel.ReqContext) response.Response
 	RouteGetAMStatus(*contextmodel.ReqContext) response.Response
 	RouteGetAlertingConfig(*contextmodel.ReqContext) response.Response
 	RouteGetGrafanaAMAlertGroups(*contextmodel.ReqContext) response.Response
 	RouteGetGrafanaAMAlerts(*contextmodel.ReqContext) response.Response
+	RouteGetGrafanaAMHealth(*contextmodel.ReqContext) response.Response
+	RouteGetGrafanaAMReadiness(*contextmodel.ReqContext) response.Response
 	RouteGetGrafanaAMStatus(*contextmodel.ReqContext) response.Response
 	RouteGetGrafanaAlertingConfig(*contextmodel.ReqContext) response.Response
 	RouteGetGrafanaReceivers(*contextmodel.ReqContext) response.Response
@@ -42,6 +46,7 @@ type AlertmanagerApi interface {
 	RoutePostAlertingConfig(*contextmodel.ReqContext) response.Response
 	RoutePostGrafanaAlertingConfig(*contextmodel.ReqContext) response.Response
 	RoutePostTestGrafanaReceivers(*contextmodel.ReqContext) response.Response
+	RouteProxyAM(*contextmodel.ReqContext) response.Response
 }
 
 func (f *AlertmanagerApiHandler) RouteCreateGrafanaSilence(ctx *contextmodel.ReqContext) response.Response {
@@ -91,6 +96,11 @@ func (f *AlertmanagerApiHandler) RouteGetAMAlerts(ctx *contextmodel.ReqContext)
 	datasourceUIDParam := web.Params(ctx.Req)[":DatasourceUID"]
 	return f.handleRouteGetAMAlerts(ctx, datasourceUIDParam)
 }
+func (f *AlertmanagerApiHandler) RouteGetAMHealth(ctx *contextmodel.ReqContext) response.Response {
+	// Parse Path Parameters
+	datasourceUIDParam := web.Params(ctx.Req)[":DatasourceUID"]
+	return f.handleRouteGetAMHealth(ctx, datasourceUIDParam)
+}
 func (f *AlertmanagerApiHandler) RouteGetAMStatus(ctx *contextmodel.ReqContext) response.Response {
 	// Parse Path Parameters
 	datasourceUIDParam := web.Params(ctx.Req)[":DatasourceUID"]
@@ -107,6 +117,12 @@ func (f *AlertmanagerApiHandler) RouteGetGrafanaAMAlertGroups(ctx *contextmodel.
 func (f *AlertmanagerApiHandler) RouteGetGrafanaAMAlerts(ctx *contextmodel.ReqContext) response.Response {
 	return f.handleRouteGetGrafanaAMAlerts(ctx)
 }
+func (f *AlertmanagerApiHandler) RouteGetGrafanaAMHealth(ctx *contextmodel.ReqContext) response.Response {
+	return f.handleRouteGetGrafanaAMHealth(ctx)
+}
+func (f *AlertmanagerApiHandler) RouteGetGrafanaAMReadiness(ctx *contextmodel.ReqContext) response.Response {
+	return f.handleRouteGetGrafanaAMReadiness(ctx)
+}
 func (f *AlertmanagerApiHandler) RouteGetGrafanaAMStatus(ctx *contextmodel.ReqContext) response.Response {
 	return f.handleRouteGetGrafanaAMStatus(ctx)
 }
@@ -172,11 +188,18 @@ func (f *AlertmanagerApiHandler) RoutePostTestGrafanaReceivers(ctx *contextmodel
 	return f.handleRoutePostTestGrafanaReceivers(ctx, conf)
 }
 
-func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics.API) {
+func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics.API, perms *AlertmanagerApiPermissions, plugins ...ReceiverPlugin) {
+	if perms == nil {
+		perms = DefaultAlertmanagerApiPermissions()
+	}
+	pluginTypes := make(receiverPluginTypes, len(plugins))
+	for _, p := range plugins {
+		pluginTypes[p.Type] = p
+	}
 	api.RouteRegister.Group("", func(group routing.RouteRegister) {
 		group.Post(
 			toMacaronPath("/api/alertmanager/grafana/api/v2/silences"),
-			api.authorize(http.MethodPost, "/api/alertmanager/grafana/api/v2/silences"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteCreateGrafanaSilence"]),
 			metrics.Instrument(
 				http.MethodPost,
 				"/api/alertmanager/grafana/api/v2/silences",
@@ -186,7 +209,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Post(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/silences"),
-			api.authorize(http.MethodPost, "/api/alertmanager/{DatasourceUID}/api/v2/silences"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteCreateSilence"]),
 			metrics.Instrument(
 				http.MethodPost,
 				"/api/alertmanager/{DatasourceUID}/api/v2/silences",
@@ -196,7 +219,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Delete(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/config/api/v1/alerts"),
-			api.authorize(http.MethodDelete, "/api/alertmanager/{DatasourceUID}/config/api/v1/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteDeleteAlertingConfig"]),
 			metrics.Instrument(
 				http.MethodDelete,
 				"/api/alertmanager/{DatasourceUID}/config/api/v1/alerts",
@@ -206,7 +229,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Delete(
 			toMacaronPath("/api/alertmanager/grafana/config/api/v1/alerts"),
-			api.authorize(http.MethodDelete, "/api/alertmanager/grafana/config/api/v1/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteDeleteGrafanaAlertingConfig"]),
 			metrics.Instrument(
 				http.MethodDelete,
 				"/api/alertmanager/grafana/config/api/v1/alerts",
@@ -216,7 +239,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Delete(
 			toMacaronPath("/api/alertmanager/grafana/api/v2/silence/{SilenceId}"),
-			api.authorize(http.MethodDelete, "/api/alertmanager/grafana/api/v2/silence/{SilenceId}"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteDeleteGrafanaSilence"]),
 			metrics.Instrument(
 				http.MethodDelete,
 				"/api/alertmanager/grafana/api/v2/silence/{SilenceId}",
@@ -226,7 +249,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Delete(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/silence/{SilenceId}"),
-			api.authorize(http.MethodDelete, "/api/alertmanager/{DatasourceUID}/api/v2/silence/{SilenceId}"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteDeleteSilence"]),
 			metrics.Instrument(
 				http.MethodDelete,
 				"/api/alertmanager/{DatasourceUID}/api/v2/silence/{SilenceId}",
@@ -236,7 +259,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/alerts/groups"),
-			api.authorize(http.MethodGet, "/api/alertmanager/{DatasourceUID}/api/v2/alerts/groups"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetAMAlertGroups"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/{DatasourceUID}/api/v2/alerts/groups",
@@ -246,7 +269,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/alerts"),
-			api.authorize(http.MethodGet, "/api/alertmanager/{DatasourceUID}/api/v2/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetAMAlerts"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/{DatasourceUID}/api/v2/alerts",
@@ -254,9 +277,19 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 				m,
 			),
 		)
+		group.Get(
+			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/healthz"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetAMHealth"]),
+			metrics.Instrument(
+				http.MethodGet,
+				"/api/alertmanager/{DatasourceUID}/api/v2/healthz",
+				srv.RouteGetAMHealth,
+				m,
+			),
+		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/status"),
-			api.authorize(http.MethodGet, "/api/alertmanager/{DatasourceUID}/api/v2/status"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetAMStatus"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/{DatasourceUID}/api/v2/status",
@@ -266,7 +299,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/config/api/v1/alerts"),
-			api.authorize(http.MethodGet, "/api/alertmanager/{DatasourceUID}/config/api/v1/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetAlertingConfig"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/{DatasourceUID}/config/api/v1/alerts",
@@ -276,7 +309,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/grafana/api/v2/alerts/groups"),
-			api.authorize(http.MethodGet, "/api/alertmanager/grafana/api/v2/alerts/groups"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaAMAlertGroups"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/grafana/api/v2/alerts/groups",
@@ -286,7 +319,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/grafana/api/v2/alerts"),
-			api.authorize(http.MethodGet, "/api/alertmanager/grafana/api/v2/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaAMAlerts"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/grafana/api/v2/alerts",
@@ -294,9 +327,29 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 				m,
 			),
 		)
+		group.Get(
+			toMacaronPath("/api/alertmanager/grafana/api/v2/healthz"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaAMHealth"]),
+			metrics.Instrument(
+				http.MethodGet,
+				"/api/alertmanager/grafana/api/v2/healthz",
+				srv.RouteGetGrafanaAMHealth,
+				m,
+			),
+		)
+		group.Get(
+			toMacaronPath("/api/alertmanager/grafana/api/v2/readyz"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaAMReadiness"]),
+			metrics.Instrument(
+				http.MethodGet,
+				"/api/alertmanager/grafana/api/v2/readyz",
+				srv.RouteGetGrafanaAMReadiness,
+				m,
+			),
+		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/grafana/api/v2/status"),
-			api.authorize(http.MethodGet, "/api/alertmanager/grafana/api/v2/status"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaAMStatus"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/grafana/api/v2/status",
@@ -306,7 +359,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/grafana/config/api/v1/alerts"),
-			api.authorize(http.MethodGet, "/api/alertmanager/grafana/config/api/v1/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaAlertingConfig"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/grafana/config/api/v1/alerts",
@@ -316,7 +369,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/grafana/config/api/v1/receivers"),
-			api.authorize(http.MethodGet, "/api/alertmanager/grafana/config/api/v1/receivers"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaReceivers"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/grafana/config/api/v1/receivers",
@@ -326,7 +379,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/grafana/api/v2/silence/{SilenceId}"),
-			api.authorize(http.MethodGet, "/api/alertmanager/grafana/api/v2/silence/{SilenceId}"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaSilence"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/grafana/api/v2/silence/{SilenceId}",
@@ -336,7 +389,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/grafana/api/v2/silences"),
-			api.authorize(http.MethodGet, "/api/alertmanager/grafana/api/v2/silences"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetGrafanaSilences"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/grafana/api/v2/silences",
@@ -346,7 +399,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/silence/{SilenceId}"),
-			api.authorize(http.MethodGet, "/api/alertmanager/{DatasourceUID}/api/v2/silence/{SilenceId}"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetSilence"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/{DatasourceUID}/api/v2/silence/{SilenceId}",
@@ -356,7 +409,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Get(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/silences"),
-			api.authorize(http.MethodGet, "/api/alertmanager/{DatasourceUID}/api/v2/silences"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteGetSilences"]),
 			metrics.Instrument(
 				http.MethodGet,
 				"/api/alertmanager/{DatasourceUID}/api/v2/silences",
@@ -366,7 +419,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Post(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/api/v2/alerts"),
-			api.authorize(http.MethodPost, "/api/alertmanager/{DatasourceUID}/api/v2/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RoutePostAMAlerts"]),
 			metrics.Instrument(
 				http.MethodPost,
 				"/api/alertmanager/{DatasourceUID}/api/v2/alerts",
@@ -376,7 +429,7 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 		)
 		group.Post(
 			toMacaronPath("/api/alertmanager/{DatasourceUID}/config/api/v1/alerts"),
-			api.authorize(http.MethodPost, "/api/alertmanager/{DatasourceUID}/config/api/v1/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RoutePostAlertingConfig"]),
 			metrics.Instrument(
 				http.MethodPost,
 				"/api/alertmanager/{DatasourceUID}/config/api/v1/alerts",
@@ -384,19 +437,23 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 				m,
 			),
 		)
+		postGrafanaAlertingConfig := srv.RoutePostGrafanaAlertingConfig
+		if handler, ok := srv.(*AlertmanagerApiHandler); ok && len(pluginTypes) > 0 {
+			postGrafanaAlertingConfig = handler.routePostGrafanaAlertingConfigWithPluginValidation(pluginTypes)
+		}
 		group.Post(
 			toMacaronPath("/api/alertmanager/grafana/config/api/v1/alerts"),
-			api.authorize(http.MethodPost, "/api/alertmanager/grafana/config/api/v1/alerts"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RoutePostGrafanaAlertingConfig"]),
 			metrics.Instrument(
 				http.MethodPost,
 				"/api/alertmanager/grafana/config/api/v1/alerts",
-				srv.RoutePostGrafanaAlertingConfig,
+				postGrafanaAlertingConfig,
 				m,
 			),
 		)
 		group.Post(
 			toMacaronPath("/api/alertmanager/grafana/config/api/v1/receivers/test"),
-			api.authorize(http.MethodPost, "/api/alertmanager/grafana/config/api/v1/receivers/test"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RoutePostTestGrafanaReceivers"]),
 			metrics.Instrument(
 				http.MethodPost,
 				"/api/alertmanager/grafana/config/api/v1/receivers/test",
@@ -404,5 +461,14 @@ func (api *API) RegisterAlertmanagerApiEndpoints(srv AlertmanagerApi, m *metrics
 				m,
 			),
 		)
+		group.Any(
+			toMacaronPath("/api/alertmanager/{DatasourceUID}/proxy/*"),
+			acmiddleware.Middleware(api.AccessControl)(perms.Evaluators["RouteProxyAM"]),
+			srv.RouteProxyAM,
+		)
+		if handler, ok := srv.(*AlertmanagerApiHandler); ok {
+			api.registerAlertmanagerQueryEndpoint(group, handler, perms, m)
+		}
+		api.registerReceiverPlugins(group, plugins, m)
 	}, middleware.ReqSignedIn)
-}
\ No newline at end of file
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	checksumsMu sync.Mutex
+	checksums   = map[string]string{}
+)
+
+// AssetChecksum returns the hex-encoded MD5 checksum of the asset's
+// decompressed bytes, computed lazily on first read and cached for
+// subsequent calls. Under an assetsdebug build the cache is bypassed so an
+// edit to the file on disk is reflected in the next ETag, matching Asset's
+// live-reload behavior.
+func AssetChecksum(name string) (string, error) {
+	cacheable := AssetsMode() == "embedded"
+
+	if cacheable {
+		checksumsMu.Lock()
+		if sum, ok := checksums[name]; ok {
+			checksumsMu.Unlock()
+			return sum, nil
+		}
+		checksumsMu.Unlock()
+	}
+
+	data, err := Asset(name)
+	if err != nil {
+		return "", err
+	}
+	sum := hex.EncodeToString(md5Sum(data))
+
+	if cacheable {
+		checksumsMu.Lock()
+		checksums[name] = sum
+		checksumsMu.Unlock()
+	}
+	return sum, nil
+}
+
+func md5Sum(data []byte) []byte {
+	sum := md5.Sum(data)
+	return sum[:]
+}
+
+// ServeAsset writes the named asset to w, setting an ETag derived from its
+// MD5 checksum and honoring If-None-Match with a 304 instead of resending
+// the body.
+func ServeAsset(w http.ResponseWriter, r *http.Request, name string) {
+	sum, err := AssetChecksum(name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	etag := fmt.Sprintf("%q", sum)
+
+	w.Header().Set("ETag", etag)
+	if match := r.Header.Get("If-None-Match"); match == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	data, err := Asset(name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	if ct := mime.TypeByExtension(filepath.Ext(name)); ct != "" {
+		w.Header().Set("Content-Type", ct)
+	}
+	w.Write(data)
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"time"
+)
+
+// assetManifestEntry is one row of the JSON asset manifest, e.g. to diff
+// between builds in CI.
+type assetManifestEntry struct {
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+}
+
+// ServeAssetsDebug handles /debug/assets: an ASCII tree of every embedded
+// asset for operators, or a JSON manifest when called with ?format=json.
+func ServeAssetsDebug(w http.ResponseWriter, r *http.Request) {
+	fileSystem := AssetFile()
+
+	if r.URL.Query().Get("format") == "json" {
+		entries, err := collectAssetManifest(fileSystem, "/")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(entries)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if err := WalkAssets(fileSystem, "/", w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// WalkAssets renders an ASCII tree of every file under root in fs, writing
+// to w, followed by a trailing "N files, M bytes" summary line.
+func WalkAssets(fileSystem http.FileSystem, root string, w io.Writer) error {
+	fmt.Fprintln(w, root)
+
+	files, bytes, err := walkAssetsDir(fileSystem, root, "", w)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "\n%d files, %d bytes\n", files, bytes)
+	return nil
+}
+
+func walkAssetsDir(fileSystem http.FileSystem, dir, prefix string, w io.Writer) (files int, size int64, err error) {
+	f, err := fileSystem.Open(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	children, err := f.Readdir(-1)
+	if err != nil {
+		return 0, 0, err
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].Name() < children[j].Name() })
+
+	for i, child := range children {
+		last := i == len(children)-1
+		branch, nextPrefix := "├─ ", prefix+"│  "
+		if last {
+			branch, nextPrefix = "└─ ", prefix+"   "
+		}
+
+		childPath := path.Join(dir, child.Name())
+		if child.IsDir() {
+			fmt.Fprintf(w, "%s%s%s\n", prefix, branch, child.Name())
+			childFiles, childSize, err := walkAssetsDir(fileSystem, childPath, nextPrefix, w)
+			if err != nil {
+				return 0, 0, err
+			}
+			files += childFiles
+			size += childSize
+			continue
+		}
+
+		fmt.Fprintf(w, "%s%s%s (%d bytes, %s)\n", prefix, branch, child.Name(),
+			child.Size(), child.ModTime().Format(time.RFC3339))
+		files++
+		size += child.Size()
+	}
+	return files, size, nil
+}
+
+func collectAssetManifest(fileSystem http.FileSystem, root string) ([]assetManifestEntry, error) {
+	var entries []assetManifestEntry
+
+	var walk func(dir string) error
+	walk = func(dir string) error {
+		f, err := fileSystem.Open(dir)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		children, err := f.Readdir(-1)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			childPath := path.Join(dir, child.Name())
+			if child.IsDir() {
+				if err := walk(childPath); err != nil {
+					return err
+				}
+				continue
+			}
+			entries = append(entries, assetManifestEntry{
+				Path:    childPath,
+				Size:    child.Size(),
+				ModTime: child.ModTime(),
+			})
+		}
+		return nil
+	}
+
+	if err := walk(root); err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries, nil
+}
@@ -0,0 +1,99 @@
+//go:build assetsdebug
+
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// debugAssetsDir is the on-disk directory assetsdebug builds read from,
+// defaulting to ./assets and overridable via GOSPLAT_ASSETS_DIR so
+// front-end developers can edit css/main.css or js/main.js without
+// rerunning code generation or rebuilding the binary.
+var debugAssetsDir = func() string {
+	if dir := os.Getenv("GOSPLAT_ASSETS_DIR"); dir != "" {
+		return dir
+	}
+	return "./assets"
+}()
+
+// Assets returns an fs.FS backed directly by debugAssetsDir.
+func Assets() fs.FS {
+	return os.DirFS(debugAssetsDir)
+}
+
+// AssetFile returns a http.FileSystem serving debugAssetsDir directly off
+// disk.
+func AssetFile() http.FileSystem {
+	return http.Dir(debugAssetsDir)
+}
+
+// Asset loads and returns the asset for the given name straight off disk.
+func Asset(name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(debugAssetsDir, canonical(name)))
+	if err != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	return data, nil
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+func MustAsset(name string) []byte {
+	b, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+	return b
+}
+
+// AssetInfo loads and returns the asset info for the given name via
+// os.Stat, so it behaves identically to the embedded build for every
+// downstream consumer.
+func AssetInfo(name string) (os.FileInfo, error) {
+	info, err := os.Stat(filepath.Join(debugAssetsDir, canonical(name)))
+	if err != nil {
+		return nil, fmt.Errorf("AssetInfo %s not found", name)
+	}
+	return info, nil
+}
+
+// AssetNames returns the names of the assets under debugAssetsDir.
+func AssetNames() []string {
+	var names []string
+	_ = filepath.WalkDir(debugAssetsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(debugAssetsDir, path)
+		if err != nil {
+			return nil
+		}
+		names = append(names, canonical(rel))
+		return nil
+	})
+	return names
+}
+
+// AssetDir returns the file names below a certain directory under
+// debugAssetsDir. AssetDir("") returns the top-level entries.
+func AssetDir(name string) ([]string, error) {
+	dir := filepath.Join(debugAssetsDir, canonical(name))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(entries))
+	for _, e := range entries {
+		rv = append(rv, e.Name())
+	}
+	return rv, nil
+}
+
+// AssetsMode reports that assets are being read live from debugAssetsDir.
+func AssetsMode() string {
+	return "disk:" + debugAssetsDir
+}
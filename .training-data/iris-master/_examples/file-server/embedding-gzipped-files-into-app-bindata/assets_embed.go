@@ -0,0 +1,105 @@
+//go:build !assetsdebug
+
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+)
+
+//go:embed assets/*
+var embeddedAssets embed.FS
+
+const assetsRoot = "assets"
+
+// Assets returns the embedded asset tree rooted at assets/, so callers can
+// plug it directly into html/template.ParseFS or fs.ReadDirFS without
+// carrying the assets/ prefix around.
+func Assets() fs.FS {
+	sub, err := fs.Sub(embeddedAssets, assetsRoot)
+	if err != nil {
+		// assetsRoot is a compile-time constant matching the embed
+		// directive above, so Sub can only fail if that invariant breaks.
+		panic(err)
+	}
+	return sub
+}
+
+// AssetFile returns a http.FileSystem instance backed by the embedded
+// assets, suitable for http.FileServer or iris' StaticWeb.
+func AssetFile() http.FileSystem {
+	return http.FS(Assets())
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or could not be
+// loaded. Kept for callers that haven't migrated to Assets()/AssetFile()
+// yet.
+func Asset(name string) ([]byte, error) {
+	b, err := fs.ReadFile(Assets(), canonical(name))
+	if err != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	return b, nil
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	b, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+	return b
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or could not be
+// loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	info, err := fs.Stat(Assets(), canonical(name))
+	if err != nil {
+		return nil, fmt.Errorf("AssetInfo %s not found", name)
+	}
+	return assetFileInfo{info}, nil
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	var names []string
+	_ = fs.WalkDir(Assets(), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		names = append(names, path)
+		return nil
+	})
+	return names
+}
+
+// AssetDir returns the file names below a certain directory in the
+// embedded tree. AssetDir("") returns the top-level entries.
+func AssetDir(name string) ([]string, error) {
+	dir := canonical(name)
+	if dir == "" {
+		dir = "."
+	}
+	entries, err := fs.ReadDir(Assets(), dir)
+	if err != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(entries))
+	for _, e := range entries {
+		rv = append(rv, e.Name())
+	}
+	return rv, nil
+}
+
+// AssetsMode reports how assets are being served, for /debug/assets and
+// similar diagnostics.
+func AssetsMode() string {
+	return "embedded"
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	gzipAssetsMu sync.Mutex
+	gzipAssets   = map[string][]byte{}
+)
+
+// AssetGzip returns the gzip-compressed bytes of the named asset,
+// compressing once on first use and caching the result so repeated
+// requests under load don't pay the compression cost again. Under an
+// assetsdebug build the cache is bypassed so edits on disk still show up
+// immediately, matching Asset's live-reload behavior.
+func AssetGzip(name string) ([]byte, error) {
+	cacheable := AssetsMode() == "embedded"
+
+	if cacheable {
+		gzipAssetsMu.Lock()
+		if data, ok := gzipAssets[name]; ok {
+			gzipAssetsMu.Unlock()
+			return data, nil
+		}
+		gzipAssetsMu.Unlock()
+	}
+
+	raw, err := Asset(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(raw); err != nil {
+		return nil, fmt.Errorf("gzip %s: %v", name, err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("gzip %s: %v", name, err)
+	}
+
+	data := buf.Bytes()
+	if cacheable {
+		gzipAssetsMu.Lock()
+		gzipAssets[name] = data
+		gzipAssetsMu.Unlock()
+	}
+	return data, nil
+}
+
+// AssetHandler serves the named asset, writing the pre-gzipped body with
+// Content-Encoding: gzip when the request's Accept-Encoding allows it, and
+// falling back to the raw body otherwise. It shares ETag/If-None-Match
+// handling with ServeAsset so conditional GETs avoid resending the body
+// regardless of which encoding was negotiated.
+func AssetHandler(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sum, err := AssetChecksum(name)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		etag := fmt.Sprintf("%q", sum)
+
+		w.Header().Set("Vary", "Accept-Encoding")
+		w.Header().Set("ETag", etag)
+		if ct := mime.TypeByExtension(filepath.Ext(name)); ct != "" {
+			w.Header().Set("Content-Type", ct)
+		}
+		if match := r.Header.Get("If-None-Match"); match == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		if acceptsGzip(r) {
+			data, err := AssetGzip(name)
+			if err != nil {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.Write(data)
+			return
+		}
+
+		data, err := Asset(name)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Write(data)
+	}
+}
+
+// acceptsGzip reports whether the request's Accept-Encoding header lists
+// gzip, ignoring any q-value or other parameter on each token.
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		enc = strings.TrimSpace(enc)
+		if name, _, _ := strings.Cut(enc, ";"); name == "gzip" {
+			return true
+		}
+	}
+	return false
+}
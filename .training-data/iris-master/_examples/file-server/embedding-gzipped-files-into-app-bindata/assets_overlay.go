@@ -0,0 +1,134 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// assetsDir, when non-empty, points at an on-disk directory that overlays
+// the embedded assets so css/main.css or js/main.js can be hot-edited
+// without rebuilding the binary. Wire it up with:
+//
+//	fileServer := AssetFileWithOverlay(assetsDir)
+var assetsDir = flag.String("assets-dir", os.Getenv("GOSPLAT_ASSETS_DIR"), "optional on-disk directory overlaying the embedded web assets")
+
+// AssetFileWithOverlay returns a http.FileSystem backed by the embedded
+// assets, layered under dir (an on-disk directory) when dir is non-empty.
+// Reads check dir first and fall through to the embedded bytes; directory
+// listings merge child names from both layers, with the OS layer winning
+// on name conflicts. When dir is empty this is identical to AssetFile().
+func AssetFileWithOverlay(dir string) http.FileSystem {
+	embedded := AssetFile()
+	if dir == "" {
+		return embedded
+	}
+	return &overlayFileSystem{os: http.Dir(dir), embedded: embedded}
+}
+
+type overlayFileSystem struct {
+	os       http.FileSystem
+	embedded http.FileSystem
+}
+
+// Open opens name from whichever layer has it, the OS layer winning on
+// conflicts. Both layers are opened when name exists in both, so a
+// directory present in both can have its listings merged in Readdir;
+// only branching on whichever layer's Open happens to succeed first (as an
+// earlier version of this did) meant the merge path was unreachable, since
+// the losing layer was never even consulted.
+func (o *overlayFileSystem) Open(name string) (http.File, error) {
+	osFile, osErr := o.os.Open(name)
+	embFile, embErr := o.embedded.Open(name)
+
+	switch {
+	case osErr == nil && embErr == nil:
+		info, err := osFile.Stat()
+		if err == nil && info.IsDir() {
+			return &overlayDir{File: osFile, os: osFile, embedded: embFile}, nil
+		}
+		embFile.Close()
+		return osFile, nil
+	case osErr == nil:
+		return osFile, nil
+	case embErr == nil:
+		info, err := embFile.Stat()
+		if err == nil && info.IsDir() {
+			return &overlayDir{File: embFile, embedded: embFile}, nil
+		}
+		return embFile, nil
+	default:
+		return nil, embErr
+	}
+}
+
+// overlayDir merges Readdir results from the OS layer and the embedded
+// layer for a directory that exists in at least one of them, so a
+// directory listing reflects files added or overridden on disk. http.File
+// is embedded for Stat/Read/Seek/Close on the "primary" handle (the OS one
+// when present); os/embedded hold whichever of the two handles actually
+// exist, for Readdir and Close to merge/release both.
+type overlayDir struct {
+	http.File
+	os, embedded http.File
+}
+
+func (d *overlayDir) Readdir(count int) ([]os.FileInfo, error) {
+	var osInfos, embeddedInfos []os.FileInfo
+	if d.os != nil {
+		infos, err := d.os.Readdir(-1)
+		if err != nil {
+			return nil, err
+		}
+		osInfos = infos
+	}
+	if d.embedded != nil {
+		infos, err := d.embedded.Readdir(-1)
+		if err != nil {
+			return nil, err
+		}
+		embeddedInfos = infos
+	}
+
+	seen := make(map[string]bool, len(osInfos))
+	merged := make([]os.FileInfo, 0, len(osInfos)+len(embeddedInfos))
+	for _, info := range osInfos {
+		seen[info.Name()] = true
+		merged = append(merged, info)
+	}
+	for _, info := range embeddedInfos {
+		if !seen[info.Name()] {
+			merged = append(merged, info)
+		}
+	}
+
+	if count > 0 && count < len(merged) {
+		merged = merged[:count]
+	}
+	return merged, nil
+}
+
+func (d *overlayDir) Close() error {
+	var err error
+	if d.os != nil {
+		if cerr := d.os.Close(); cerr != nil {
+			err = cerr
+		}
+	}
+	if d.embedded != nil && d.embedded != d.os {
+		if cerr := d.embedded.Close(); cerr != nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// assetsDirFlag returns the -assets-dir/GOSPLAT_ASSETS_DIR value, cleaned,
+// or "" when neither was set.
+func assetsDirFlag() string {
+	if *assetsDir == "" {
+		return ""
+	}
+	return filepath.Clean(*assetsDir)
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newOverlayFS builds an overlayFileSystem backed by two real on-disk
+// directories, so the merge logic can be exercised without depending on the
+// generated bindata embedded assets.
+func newOverlayFS(t *testing.T, osDir, embeddedDir string) *overlayFileSystem {
+	t.Helper()
+	return &overlayFileSystem{os: http.Dir(osDir), embedded: http.Dir(embeddedDir)}
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatalf("error writing %s: %v", name, err)
+	}
+}
+
+func TestOverlayFileSystem_Open(t *testing.T) {
+	osDir := t.TempDir()
+	embeddedDir := t.TempDir()
+
+	writeFile(t, osDir, "os-only.txt", "os-only")
+	writeFile(t, osDir, "shared.txt", "from-os")
+	writeFile(t, embeddedDir, "embedded-only.txt", "embedded-only")
+	writeFile(t, embeddedDir, "shared.txt", "from-embedded")
+
+	fs := newOverlayFS(t, osDir, embeddedDir)
+
+	cases := []struct {
+		name string
+		want string
+	}{
+		{"/os-only.txt", "os-only"},
+		{"/embedded-only.txt", "embedded-only"},
+		{"/shared.txt", "from-os"}, // OS layer wins on conflicts
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := fs.Open(tc.name)
+			if err != nil {
+				t.Fatalf("Open(%q) returned error: %v", tc.name, err)
+			}
+			defer f.Close()
+			data, err := io.ReadAll(f)
+			if err != nil {
+				t.Fatalf("error reading %q: %v", tc.name, err)
+			}
+			if string(data) != tc.want {
+				t.Errorf("Open(%q) = %q, want %q", tc.name, data, tc.want)
+			}
+		})
+	}
+
+	if _, err := fs.Open("/missing.txt"); err == nil {
+		t.Error("Open(/missing.txt) returned nil error, want not found")
+	}
+}
+
+func TestOverlayDir_Readdir_Merges(t *testing.T) {
+	osDir := t.TempDir()
+	embeddedDir := t.TempDir()
+
+	writeFile(t, osDir, "os-only.txt", "os-only")
+	writeFile(t, osDir, "shared.txt", "from-os")
+	writeFile(t, embeddedDir, "embedded-only.txt", "embedded-only")
+	writeFile(t, embeddedDir, "shared.txt", "from-embedded")
+
+	fs := newOverlayFS(t, osDir, embeddedDir)
+
+	f, err := fs.Open("/")
+	if err != nil {
+		t.Fatalf("Open(/) returned error: %v", err)
+	}
+	defer f.Close()
+
+	infos, err := f.Readdir(-1)
+	if err != nil {
+		t.Fatalf("Readdir(-1) returned error: %v", err)
+	}
+
+	names := make(map[string]bool, len(infos))
+	for _, info := range infos {
+		names[info.Name()] = true
+	}
+	for _, want := range []string{"os-only.txt", "embedded-only.txt", "shared.txt"} {
+		if !names[want] {
+			t.Errorf("Readdir(-1) missing %q, got %v", want, names)
+		}
+	}
+	if n := len(infos); n != 3 {
+		t.Errorf("Readdir(-1) returned %d entries (shared.txt should be deduped), want 3: %v", n, names)
+	}
+}
+
+func TestOverlayDir_Readdir_HonorsCount(t *testing.T) {
+	osDir := t.TempDir()
+	embeddedDir := t.TempDir()
+
+	writeFile(t, osDir, "a.txt", "a")
+	writeFile(t, osDir, "b.txt", "b")
+	writeFile(t, embeddedDir, "c.txt", "c")
+
+	fs := newOverlayFS(t, osDir, embeddedDir)
+
+	f, err := fs.Open("/")
+	if err != nil {
+		t.Fatalf("Open(/) returned error: %v", err)
+	}
+	defer f.Close()
+
+	infos, err := f.Readdir(1)
+	if err != nil {
+		t.Fatalf("Readdir(1) returned error: %v", err)
+	}
+	if len(infos) != 1 {
+		t.Errorf("Readdir(1) returned %d entries, want 1", len(infos))
+	}
+}